@@ -1,13 +1,16 @@
 // Package cgroupv2 provides a simple API for containers to read their own
-// cgroup v2 resource usage as percentages of configured limits.
+// cgroup resource usage as percentages of configured limits.
 //
 // This package is designed for applications running inside containers that need
 // to know their resource consumption relative to container limits (not host resources).
 // Common use cases include backpressure, auto-scaling decisions, and resource monitoring.
 //
-// The package reads directly from the cgroup v2 unified hierarchy mounted at
-// /sys/fs/cgroup. It requires cgroup v2 (unified hierarchy) which is the default
-// on modern Linux distributions and Kubernetes v1.25+.
+// The package reads directly from the cgroup hierarchy mounted at /sys/fs/cgroup.
+// It targets cgroup v2 (unified hierarchy), the default on modern Linux
+// distributions and Kubernetes v1.25+, and transparently falls back to the
+// legacy cgroup v1 per-controller hierarchy on older kernels (e.g. CentOS 7,
+// EKS on Amazon Linux 2). See Monitor.Version to inspect which hierarchy was
+// detected.
 package cgroupv2
 
 import (
@@ -44,16 +47,47 @@ type Stats struct {
 	// MemoryPercent is memory usage as percentage of limit (0-100).
 	MemoryPercent float64
 
-	// MemoryBytes is current memory usage in bytes.
+	// MemoryBytes is current memory usage in bytes, as reported by
+	// memory.current (memory.usage_in_bytes on cgroup v1). Kept for
+	// backward compatibility; prefer
+	// MemoryWorkingSetBytes, which is what the kernel OOM killer actually
+	// watches, since it excludes reclaimable page cache.
 	MemoryBytes uint64
 
 	// MemoryLimitBytes is the memory limit in bytes.
 	// Returns 0 if no limit is set.
 	MemoryLimitBytes uint64
 
+	// MemoryWorkingSetBytes is memory.current minus inactive (reclaimable)
+	// file-backed pages, matching how Docker, Kubernetes, and Podman report
+	// container memory usage. On cgroup v1, memory.stat is not read and this
+	// equals MemoryBytes.
+	MemoryWorkingSetBytes uint64
+
+	// MemoryWorkingSetPercent is MemoryWorkingSetBytes as a percentage of
+	// MemoryLimitBytes.
+	MemoryWorkingSetPercent float64
+
 	// CPULimitCores is the CPU limit in cores (e.g., 0.5, 1.0, 2.0).
 	// Returns 0 if no limit is set.
 	CPULimitCores float64
+
+	// PSI holds Pressure Stall Information for CPU, memory, and I/O.
+	// Zero-valued if the kernel doesn't expose PSI (see ErrPSIUnavailable).
+	PSI PressureStats
+
+	// IOReadBytes and IOWriteBytes are cumulative block I/O byte counters
+	// since container start, aggregated across all devices.
+	IOReadBytes  uint64
+	IOWriteBytes uint64
+
+	// IOReadOps and IOWriteOps are cumulative block I/O operation counters
+	// since container start, aggregated across all devices.
+	IOReadOps  uint64
+	IOWriteOps uint64
+
+	// MemoryEvents holds the memory.events counters (low/high/max/oom/oom_kill).
+	MemoryEvents MemoryEvents
 }
 
 // Monitor tracks cgroup resource usage over time.
@@ -66,6 +100,14 @@ type Monitor struct {
 	lastCPUUsageUsec  uint64
 	lastCPUSampleTime time.Time
 	hasBaseline       bool
+
+	version       Version
+	forcedVersion Version
+
+	lastIOReadBytes  uint64
+	lastIOWriteBytes uint64
+	lastIOSampleTime time.Time
+	hasIOBaseline    bool
 }
 
 // NewMonitor creates a new cgroup monitor.
@@ -96,6 +138,27 @@ func (m *Monitor) Stats() Stats {
 	s.MemoryBytes = memBytes
 	s.MemoryLimitBytes = memLimit
 
+	workingSet := memBytes
+	if stat := m.readMemoryStat(); stat.inactiveFile < memBytes {
+		workingSet = memBytes - stat.inactiveFile
+	}
+	s.MemoryWorkingSetBytes = workingSet
+	if memLimit > 0 {
+		s.MemoryWorkingSetPercent = float64(workingSet) / float64(memLimit) * 100
+	}
+
+	if psi, err := m.readPressure(); err == nil {
+		s.PSI = psi
+	}
+
+	io := m.readIOAggregate()
+	s.IOReadBytes = io.ReadBytes
+	s.IOWriteBytes = io.WriteBytes
+	s.IOReadOps = io.ReadOps
+	s.IOWriteOps = io.WriteOps
+
+	s.MemoryEvents = m.readMemoryEvents()
+
 	return s
 }
 
@@ -112,6 +175,9 @@ func (m *Monitor) CPUPercent() float64 {
 // MemoryPercent returns current memory usage as percentage of limit.
 // Returns 0 if no memory limit is set.
 func (m *Monitor) MemoryPercent() float64 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
 	pct, _, _, _ := m.readMemory()
 	return pct
 }
@@ -8,6 +8,14 @@ import (
 // readCPU reads CPU usage and calculates percentage of container limit.
 // Returns (percent, limitCores, error).
 func (m *Monitor) readCPU() (float64, float64, error) {
+	if m.detectVersion() == VersionV1 {
+		return m.readCPUV1()
+	}
+	return m.readCPUV2()
+}
+
+// readCPUV2 reads CPU usage from the cgroup v2 cpu.max/cpu.stat files.
+func (m *Monitor) readCPUV2() (float64, float64, error) {
 	// Read CPU limit from cpu.max (format: "quota period" or "max period")
 	cpuMax, err := readFile(m.cgroupPath + "/cpu.max")
 	if err != nil {
@@ -45,6 +53,52 @@ func (m *Monitor) readCPU() (float64, float64, error) {
 	}
 
 	usageUsec := parseCPUStatUsage(cpuStat)
+	return m.cpuPercentFromUsage(usageUsec, cpuLimitCores), cpuLimitCores, nil
+}
+
+// readCPUV1 reads CPU usage from the legacy cgroup v1 per-controller files
+// (cpu.cfs_quota_us/cpu.cfs_period_us for the limit, cpuacct.usage for usage).
+func (m *Monitor) readCPUV1() (float64, float64, error) {
+	quotaStr, err := readFile(m.cgroupPath + "/" + v1CPUPath + "/cpu.cfs_quota_us")
+	if err != nil {
+		return 0, 0, err
+	}
+
+	quota, err := parseInt64(quotaStr)
+	if err != nil || quota <= 0 {
+		return 0, 0, nil
+	}
+
+	periodStr, err := readFile(m.cgroupPath + "/" + v1CPUPath + "/cpu.cfs_period_us")
+	if err != nil {
+		return 0, 0, err
+	}
+
+	period, err := parseInt64(periodStr)
+	if err != nil || period == 0 {
+		return 0, 0, nil
+	}
+
+	cpuLimitCores := float64(quota) / float64(period)
+
+	usageStr, err := readFile(m.cgroupPath + "/" + v1CPUPath + "/cpuacct.usage")
+	if err != nil {
+		return 0, cpuLimitCores, err
+	}
+
+	// cpuacct.usage is in nanoseconds; readCPU's baseline tracks microseconds.
+	usageNsec, err := parseUint64(usageStr)
+	if err != nil {
+		return 0, cpuLimitCores, nil
+	}
+	usageUsec := usageNsec / 1000
+
+	return m.cpuPercentFromUsage(usageUsec, cpuLimitCores), cpuLimitCores, nil
+}
+
+// cpuPercentFromUsage applies the delta-baseline calculation shared by the v1
+// and v2 readers. usageUsec is cumulative CPU time in microseconds.
+func (m *Monitor) cpuPercentFromUsage(usageUsec uint64, cpuLimitCores float64) float64 {
 	now := time.Now()
 
 	// First sample - establish baseline
@@ -52,20 +106,20 @@ func (m *Monitor) readCPU() (float64, float64, error) {
 		m.lastCPUUsageUsec = usageUsec
 		m.lastCPUSampleTime = now
 		m.hasBaseline = true
-		return 0, cpuLimitCores, nil
+		return 0
 	}
 
 	// Handle counter reset (container restart, cgroup reset)
 	if usageUsec < m.lastCPUUsageUsec {
 		m.lastCPUUsageUsec = usageUsec
 		m.lastCPUSampleTime = now
-		return 0, cpuLimitCores, nil
+		return 0
 	}
 
 	usageDelta := float64(usageUsec - m.lastCPUUsageUsec)
 	timeDelta := now.Sub(m.lastCPUSampleTime)
 	if timeDelta == 0 {
-		return 0, cpuLimitCores, nil
+		return 0
 	}
 
 	// CPU% = (CPU microseconds used / elapsed microseconds) / limit cores * 100
@@ -76,7 +130,7 @@ func (m *Monitor) readCPU() (float64, float64, error) {
 	m.lastCPUUsageUsec = usageUsec
 	m.lastCPUSampleTime = now
 
-	return cpuPercent, cpuLimitCores, nil
+	return cpuPercent
 }
 
 func parseCPUStatUsage(content string) uint64 {
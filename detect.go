@@ -0,0 +1,116 @@
+package cgroupv2
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	procSelfCgroup    = "/proc/self/cgroup"
+	procSelfMountinfo = "/proc/self/mountinfo"
+)
+
+// ErrCgroupPathNotFound is returned by DetectCgroupPath when the process's
+// own cgroup v2 path can't be determined (no cgroup2 mount found, or
+// /proc/self/cgroup is missing or not in v2 format).
+var ErrCgroupPathNotFound = errors.New("cgroupv2: could not detect cgroup path")
+
+// WithAutoDetect configures the Monitor to use DetectCgroupPath at
+// construction time instead of the default /sys/fs/cgroup. This is what
+// systemd-managed environments (or Kubernetes with the cgroupfs driver under
+// a kubepods.slice/... subtree) need, since the relevant limits live in a
+// sub-path rather than at the mount root. Falls back to the default path if
+// detection fails.
+func WithAutoDetect() Option {
+	return func(m *Monitor) {
+		if path, err := DetectCgroupPath(); err == nil {
+			m.cgroupPath = path
+		}
+	}
+}
+
+// DetectCgroupPath finds the calling process's own cgroup v2 directory by
+// combining the cgroup2 mountpoint from /proc/self/mountinfo with the
+// process's cgroup suffix from /proc/self/cgroup.
+func DetectCgroupPath() (string, error) {
+	mountpoint, err := findCgroup2Mountpoint(procSelfMountinfo)
+	if err != nil {
+		return "", fmt.Errorf("%w: %v", ErrCgroupPathNotFound, err)
+	}
+
+	suffix, err := findSelfCgroupSuffix(procSelfCgroup)
+	if err != nil {
+		return "", fmt.Errorf("%w: %v", ErrCgroupPathNotFound, err)
+	}
+
+	return filepath.Join(mountpoint, suffix), nil
+}
+
+// findCgroup2Mountpoint scans a /proc/<pid>/mountinfo-formatted file for the
+// cgroup2 filesystem's mountpoint.
+//
+// mountinfo lines look like:
+//
+//	36 25 0:30 / /sys/fs/cgroup rw,nosuid,nodev,noexec,relatime shared:10 - cgroup2 cgroup2 rw
+//
+// Fields before " - " are optional and variable in count, so the filesystem
+// type is the first field after that separator.
+func findCgroup2Mountpoint(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := scanner.Text()
+		parts := strings.SplitN(line, " - ", 2)
+		if len(parts) != 2 {
+			continue
+		}
+
+		preFields := strings.Fields(parts[0])
+		postFields := strings.Fields(parts[1])
+		if len(preFields) < 5 || len(postFields) < 1 {
+			continue
+		}
+
+		if postFields[0] != "cgroup2" {
+			continue
+		}
+
+		return preFields[4], nil // mount point
+	}
+	if err := scanner.Err(); err != nil {
+		return "", err
+	}
+
+	return "", errors.New("no cgroup2 mount found")
+}
+
+// findSelfCgroupSuffix parses the v2 line of a /proc/<pid>/cgroup file,
+// which has the format "0::/path/to/cgroup".
+func findSelfCgroupSuffix(path string) (string, error) {
+	content, err := readFile(path)
+	if err != nil {
+		return "", err
+	}
+
+	for _, line := range strings.Split(content, "\n") {
+		parts := strings.SplitN(line, ":", 3)
+		if len(parts) != 3 {
+			continue
+		}
+		// v2 entries have an empty controller list (the middle field).
+		if parts[0] == "0" && parts[1] == "" {
+			return parts[2], nil
+		}
+	}
+
+	return "", errors.New("no cgroup v2 entry found")
+}
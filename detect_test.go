@@ -0,0 +1,90 @@
+package cgroupv2
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestFindCgroup2Mountpoint(t *testing.T) {
+	content := `22 28 0:21 / /sys/fs/cgroup rw,nosuid,nodev,noexec,relatime shared:5 - cgroup2 cgroup2 rw,seclabel
+23 28 0:5 / /dev rw,nosuid shared:2 - devtmpfs devtmpfs rw,size=4096k,nr_inodes=1048576
+`
+	path := writeTempFile(t, content)
+
+	mp, err := findCgroup2Mountpoint(path)
+	if err != nil {
+		t.Fatalf("findCgroup2Mountpoint() error = %v", err)
+	}
+	if mp != "/sys/fs/cgroup" {
+		t.Errorf("mountpoint = %q, want /sys/fs/cgroup", mp)
+	}
+}
+
+func TestFindCgroup2Mountpoint_NotFound(t *testing.T) {
+	content := `23 28 0:5 / /dev rw,nosuid shared:2 - devtmpfs devtmpfs rw
+`
+	path := writeTempFile(t, content)
+
+	_, err := findCgroup2Mountpoint(path)
+	if err == nil {
+		t.Error("expected error when no cgroup2 mount is present")
+	}
+}
+
+func TestFindSelfCgroupSuffix(t *testing.T) {
+	content := "0::/kubepods.slice/kubepods-burstable.slice/pod123/container456\n"
+	path := writeTempFile(t, content)
+
+	suffix, err := findSelfCgroupSuffix(path)
+	if err != nil {
+		t.Fatalf("findSelfCgroupSuffix() error = %v", err)
+	}
+	want := "/kubepods.slice/kubepods-burstable.slice/pod123/container456"
+	if suffix != want {
+		t.Errorf("suffix = %q, want %q", suffix, want)
+	}
+}
+
+func TestFindSelfCgroupSuffix_V1Only(t *testing.T) {
+	content := "11:cpu,cpuacct:/docker/abc123\n10:memory:/docker/abc123\n"
+	path := writeTempFile(t, content)
+
+	_, err := findSelfCgroupSuffix(path)
+	if err == nil {
+		t.Error("expected error when no v2 entry is present")
+	}
+}
+
+func TestDetectCgroupPath_DoesNotPanic(t *testing.T) {
+	// DetectCgroupPath reads the real /proc/self/*, which may or may not be
+	// in v2 format depending on the test environment. Just assert it returns
+	// a well-formed result either way.
+	path, err := DetectCgroupPath()
+	if err != nil && !errors.Is(err, ErrCgroupPathNotFound) {
+		t.Errorf("unexpected error type: %v", err)
+	}
+	if err == nil && path == "" {
+		t.Error("DetectCgroupPath() returned no error but an empty path")
+	}
+}
+
+func TestWithAutoDetect_FallsBackOnError(t *testing.T) {
+	// On a machine without /proc/self/cgroup in v2 format, WithAutoDetect
+	// must not leave cgroupPath empty.
+	m := NewMonitor(WithAutoDetect())
+	if m.cgroupPath == "" {
+		t.Error("cgroupPath is empty after WithAutoDetect()")
+	}
+}
+
+func writeTempFile(tb testing.TB, content string) string {
+	tb.Helper()
+	dir := tb.TempDir()
+	path := filepath.Join(dir, "procfile")
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		tb.Fatal(err)
+	}
+	return path
+}
@@ -0,0 +1,74 @@
+package cgroupv2
+
+import "strings"
+
+// MemoryEvents holds the counters from memory.events, which the kernel
+// increments as memory-related events occur in the cgroup. memory.events.local
+// is not read.
+type MemoryEvents struct {
+	// Low is the number of times the cgroup breached its memory.low boundary
+	// and had to reclaim memory.
+	Low uint64
+	// High is the number of times the cgroup breached its memory.high limit.
+	High uint64
+	// Max is the number of times the cgroup breached its memory.max limit.
+	Max uint64
+	// OOM is the number of times the OOM killer was invoked for the cgroup.
+	OOM uint64
+	// OOMKill is the number of processes in the cgroup killed by the OOM killer.
+	OOMKill uint64
+}
+
+// MemoryEvents reads and parses memory.events.
+func (m *Monitor) MemoryEvents() MemoryEvents {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.readMemoryEvents()
+}
+
+// readMemoryEvents does the work of MemoryEvents. Callers must hold m.mu.
+func (m *Monitor) readMemoryEvents() MemoryEvents {
+	content, err := readFile(m.cgroupPath + "/memory.events")
+	if err != nil {
+		return MemoryEvents{}
+	}
+	return parseMemoryEvents(content)
+}
+
+// parseMemoryEvents parses the "key value" lines of memory.events, e.g.:
+//
+//	low 0
+//	high 2
+//	max 0
+//	oom 0
+//	oom_kill 0
+func parseMemoryEvents(content string) MemoryEvents {
+	var events MemoryEvents
+
+	for _, line := range strings.Split(content, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) != 2 {
+			continue
+		}
+
+		val, err := parseUint64(fields[1])
+		if err != nil {
+			continue
+		}
+
+		switch fields[0] {
+		case "low":
+			events.Low = val
+		case "high":
+			events.High = val
+		case "max":
+			events.Max = val
+		case "oom":
+			events.OOM = val
+		case "oom_kill":
+			events.OOMKill = val
+		}
+	}
+
+	return events
+}
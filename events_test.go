@@ -0,0 +1,52 @@
+package cgroupv2
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestParseMemoryEvents(t *testing.T) {
+	content := "low 1\nhigh 2\nmax 3\noom 4\noom_kill 5\n"
+	events := parseMemoryEvents(content)
+
+	if events.Low != 1 || events.High != 2 || events.Max != 3 || events.OOM != 4 || events.OOMKill != 5 {
+		t.Errorf("parseMemoryEvents() = %+v, unexpected values", events)
+	}
+}
+
+func TestMonitor_MemoryEvents(t *testing.T) {
+	dir := setupTestCgroup(t, testCgroupFiles{})
+	writeCgroupFile(t, filepath.Join(dir, "memory.events"), "low 0\nhigh 0\nmax 0\noom 1\noom_kill 1\n")
+
+	m := NewMonitor(WithCgroupPath(dir))
+	events := m.MemoryEvents()
+
+	if events.OOM != 1 || events.OOMKill != 1 {
+		t.Errorf("MemoryEvents() = %+v, want OOM=1 OOMKill=1", events)
+	}
+}
+
+func TestMonitor_MemoryEvents_MissingFile(t *testing.T) {
+	dir := setupTestCgroup(t, testCgroupFiles{})
+	m := NewMonitor(WithCgroupPath(dir))
+
+	events := m.MemoryEvents()
+	if events != (MemoryEvents{}) {
+		t.Errorf("MemoryEvents() with missing file = %+v, want zero value", events)
+	}
+}
+
+func TestStats_IncludesMemoryEvents(t *testing.T) {
+	dir := setupTestCgroup(t, testCgroupFiles{
+		memoryMax: "1000000000",
+		memoryCur: "500000000",
+	})
+	writeCgroupFile(t, filepath.Join(dir, "memory.events"), "low 0\nhigh 0\nmax 0\noom 2\noom_kill 1\n")
+
+	m := NewMonitor(WithCgroupPath(dir))
+	stats := m.Stats()
+
+	if stats.MemoryEvents.OOM != 2 {
+		t.Errorf("Stats().MemoryEvents.OOM = %d, want 2", stats.MemoryEvents.OOM)
+	}
+}
@@ -0,0 +1,135 @@
+package cgroupv2
+
+import (
+	"strings"
+	"time"
+)
+
+// IODeviceStats holds cumulative block I/O counters for a single device, as
+// reported by one line of io.stat.
+type IODeviceStats struct {
+	ReadBytes  uint64
+	WriteBytes uint64
+	ReadOps    uint64
+	WriteOps   uint64
+}
+
+// add accumulates another device's counters into the aggregate.
+func (s *IODeviceStats) add(o IODeviceStats) {
+	s.ReadBytes += o.ReadBytes
+	s.WriteBytes += o.WriteBytes
+	s.ReadOps += o.ReadOps
+	s.WriteOps += o.WriteOps
+}
+
+// IOStatByDevice reads io.stat and returns per-device cumulative I/O
+// counters, keyed by "major:minor" (e.g. "8:0").
+func (m *Monitor) IOStatByDevice() map[string]IODeviceStats {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.readIOStatByDevice()
+}
+
+// readIOStatByDevice does the work of IOStatByDevice. Callers must hold m.mu.
+func (m *Monitor) readIOStatByDevice() map[string]IODeviceStats {
+	content, err := readFile(m.cgroupPath + "/io.stat")
+	if err != nil {
+		return nil
+	}
+	return parseIOStat(content)
+}
+
+// readIOAggregate reads io.stat and sums counters across all devices.
+// Callers must hold m.mu.
+func (m *Monitor) readIOAggregate() IODeviceStats {
+	var total IODeviceStats
+	for _, dev := range m.readIOStatByDevice() {
+		total.add(dev)
+	}
+	return total
+}
+
+// parseIOStat parses io.stat, whose lines look like:
+//
+//	8:0 rbytes=1024 wbytes=2048 rios=10 wios=5 dbytes=0 dios=0
+//
+// one per device major:minor.
+func parseIOStat(content string) map[string]IODeviceStats {
+	devices := make(map[string]IODeviceStats)
+
+	for _, line := range strings.Split(content, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+
+		dev := fields[0]
+		var stats IODeviceStats
+		for _, field := range fields[1:] {
+			kv := strings.SplitN(field, "=", 2)
+			if len(kv) != 2 {
+				continue
+			}
+			val, err := parseUint64(kv[1])
+			if err != nil {
+				continue
+			}
+			switch kv[0] {
+			case "rbytes":
+				stats.ReadBytes = val
+			case "wbytes":
+				stats.WriteBytes = val
+			case "rios":
+				stats.ReadOps = val
+			case "wios":
+				stats.WriteOps = val
+			}
+		}
+
+		devices[dev] = stats
+	}
+
+	return devices
+}
+
+// IORate returns the current read and write throughput in bytes per second,
+// computed as the delta between this call and the previous one divided by
+// the elapsed time - the same baseline technique readCPU uses for CPU%.
+// The first call establishes the baseline and returns (0, 0); counter resets
+// (e.g. container restart) are handled the same way as readCPU.
+func (m *Monitor) IORate() (readBps, writeBps float64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	agg := m.readIOAggregate()
+	now := time.Now()
+
+	if !m.hasIOBaseline {
+		m.lastIOReadBytes = agg.ReadBytes
+		m.lastIOWriteBytes = agg.WriteBytes
+		m.lastIOSampleTime = now
+		m.hasIOBaseline = true
+		return 0, 0
+	}
+
+	if agg.ReadBytes < m.lastIOReadBytes || agg.WriteBytes < m.lastIOWriteBytes {
+		m.lastIOReadBytes = agg.ReadBytes
+		m.lastIOWriteBytes = agg.WriteBytes
+		m.lastIOSampleTime = now
+		return 0, 0
+	}
+
+	elapsed := now.Sub(m.lastIOSampleTime).Seconds()
+	if elapsed == 0 {
+		return 0, 0
+	}
+
+	readBps = float64(agg.ReadBytes-m.lastIOReadBytes) / elapsed
+	writeBps = float64(agg.WriteBytes-m.lastIOWriteBytes) / elapsed
+
+	m.lastIOReadBytes = agg.ReadBytes
+	m.lastIOWriteBytes = agg.WriteBytes
+	m.lastIOSampleTime = now
+
+	return readBps, writeBps
+}
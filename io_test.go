@@ -0,0 +1,86 @@
+package cgroupv2
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestParseIOStat(t *testing.T) {
+	content := "8:0 rbytes=1024 wbytes=2048 rios=10 wios=5 dbytes=0 dios=0\n" +
+		"8:16 rbytes=512 wbytes=256 rios=2 wios=1 dbytes=0 dios=0\n"
+
+	devices := parseIOStat(content)
+	if len(devices) != 2 {
+		t.Fatalf("parseIOStat() returned %d devices, want 2", len(devices))
+	}
+
+	d0 := devices["8:0"]
+	if d0.ReadBytes != 1024 || d0.WriteBytes != 2048 || d0.ReadOps != 10 || d0.WriteOps != 5 {
+		t.Errorf("device 8:0 = %+v, unexpected values", d0)
+	}
+}
+
+func TestMonitor_IOStatByDevice(t *testing.T) {
+	dir := setupTestCgroup(t, testCgroupFiles{})
+	writeCgroupFile(t, filepath.Join(dir, "io.stat"),
+		"8:0 rbytes=100 wbytes=200 rios=1 wios=2 dbytes=0 dios=0\n")
+
+	m := NewMonitor(WithCgroupPath(dir))
+	devices := m.IOStatByDevice()
+	if devices["8:0"].ReadBytes != 100 {
+		t.Errorf("ReadBytes = %d, want 100", devices["8:0"].ReadBytes)
+	}
+}
+
+func TestStats_IOAggregatesAcrossDevices(t *testing.T) {
+	dir := setupTestCgroup(t, testCgroupFiles{})
+	writeCgroupFile(t, filepath.Join(dir, "io.stat"),
+		"8:0 rbytes=100 wbytes=200 rios=1 wios=2 dbytes=0 dios=0\n"+
+			"8:16 rbytes=50 wbytes=25 rios=1 wios=1 dbytes=0 dios=0\n")
+
+	m := NewMonitor(WithCgroupPath(dir))
+	stats := m.Stats()
+
+	if stats.IOReadBytes != 150 || stats.IOWriteBytes != 225 {
+		t.Errorf("IO bytes = read %d write %d, want 150/225", stats.IOReadBytes, stats.IOWriteBytes)
+	}
+	if stats.IOReadOps != 2 || stats.IOWriteOps != 3 {
+		t.Errorf("IO ops = read %d write %d, want 2/3", stats.IOReadOps, stats.IOWriteOps)
+	}
+}
+
+func TestMonitor_IORate(t *testing.T) {
+	dir := setupTestCgroup(t, testCgroupFiles{})
+	writeCgroupFile(t, filepath.Join(dir, "io.stat"), "8:0 rbytes=1000 wbytes=0 rios=1 wios=0 dbytes=0 dios=0\n")
+
+	m := NewMonitor(WithCgroupPath(dir))
+
+	readBps, writeBps := m.IORate()
+	if readBps != 0 || writeBps != 0 {
+		t.Errorf("first call should return 0,0; got %f,%f", readBps, writeBps)
+	}
+
+	time.Sleep(10 * time.Millisecond)
+	writeCgroupFile(t, filepath.Join(dir, "io.stat"), "8:0 rbytes=2000 wbytes=0 rios=2 wios=0 dbytes=0 dios=0\n")
+
+	readBps, _ = m.IORate()
+	if readBps <= 0 {
+		t.Errorf("second call should return positive read rate, got %f", readBps)
+	}
+}
+
+func TestMonitor_IORate_CounterReset(t *testing.T) {
+	dir := setupTestCgroup(t, testCgroupFiles{})
+	writeCgroupFile(t, filepath.Join(dir, "io.stat"), "8:0 rbytes=1000 wbytes=1000 rios=1 wios=1 dbytes=0 dios=0\n")
+
+	m := NewMonitor(WithCgroupPath(dir))
+	m.IORate()
+	time.Sleep(10 * time.Millisecond)
+
+	writeCgroupFile(t, filepath.Join(dir, "io.stat"), "8:0 rbytes=10 wbytes=10 rios=1 wios=1 dbytes=0 dios=0\n")
+	readBps, writeBps := m.IORate()
+	if readBps != 0 || writeBps != 0 {
+		t.Errorf("counter reset should reset baseline and return 0,0; got %f,%f", readBps, writeBps)
+	}
+}
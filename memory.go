@@ -3,6 +3,15 @@ package cgroupv2
 // readMemory reads memory usage and limit from cgroup.
 // Returns (percent, currentBytes, limitBytes, error).
 func (m *Monitor) readMemory() (float64, uint64, uint64, error) {
+	if m.detectVersion() == VersionV1 {
+		return m.readMemoryV1()
+	}
+	return m.readMemoryV2()
+}
+
+// readMemoryV2 reads memory usage and limit from the cgroup v2 memory.max
+// and memory.current files.
+func (m *Monitor) readMemoryV2() (float64, uint64, uint64, error) {
 	memMax, err := readFile(m.cgroupPath + "/memory.max")
 	if err != nil {
 		return 0, 0, 0, err
@@ -31,3 +40,37 @@ func (m *Monitor) readMemory() (float64, uint64, uint64, error) {
 
 	return memPercent, memBytes, memLimit, nil
 }
+
+// readMemoryV1 reads memory usage and limit from the legacy cgroup v1
+// memory.limit_in_bytes and memory.usage_in_bytes files.
+func (m *Monitor) readMemoryV1() (float64, uint64, uint64, error) {
+	memMax, err := readFile(m.cgroupPath + "/" + v1MemoryPath + "/memory.limit_in_bytes")
+	if err != nil {
+		return 0, 0, 0, err
+	}
+
+	memLimit, err := parseUint64(memMax)
+	if err != nil {
+		return 0, 0, 0, nil
+	}
+
+	// cgroup v1 reports "no limit" as a very large sentinel value (typically
+	// close to the architecture's max page count), rather than "max".
+	if memLimit > maxV1MemoryLimitBytes {
+		return 0, 0, 0, nil
+	}
+
+	memCurrent, err := readFile(m.cgroupPath + "/" + v1MemoryPath + "/memory.usage_in_bytes")
+	if err != nil {
+		return 0, 0, memLimit, err
+	}
+
+	memBytes, err := parseUint64(memCurrent)
+	if err != nil {
+		return 0, 0, memLimit, nil
+	}
+
+	memPercent := float64(memBytes) / float64(memLimit) * 100
+
+	return memPercent, memBytes, memLimit, nil
+}
@@ -0,0 +1,65 @@
+package cgroupv2
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestMonitor_MemoryStat(t *testing.T) {
+	dir := setupTestCgroup(t, testCgroupFiles{})
+	writeCgroupFile(t, filepath.Join(dir, "memory.stat"),
+		"anon 100\nfile 200\nkernel 30\nsock 5\nslab 10\npgmajfault 7\ninactive_file 50\n")
+
+	m := NewMonitor(WithCgroupPath(dir))
+	stat := m.MemoryStat()
+
+	if stat.Anon != 100 || stat.File != 200 || stat.Kernel != 30 ||
+		stat.Sock != 5 || stat.Slab != 10 || stat.PgMajFault != 7 {
+		t.Errorf("MemoryStat() = %+v, unexpected values", stat)
+	}
+}
+
+func TestMonitor_MemoryStat_V1ReturnsZero(t *testing.T) {
+	dir := setupTestV1Cgroup(t, testV1CgroupFiles{
+		limit:   "1000000000",
+		usageIn: "500000000",
+	})
+	m := NewMonitor(WithCgroupPath(dir), WithForceVersion(VersionV1))
+
+	stat := m.MemoryStat()
+	if stat != (MemoryStat{}) {
+		t.Errorf("MemoryStat() on v1 = %+v, want zero value", stat)
+	}
+}
+
+func TestStats_WorkingSetExcludesInactiveFile(t *testing.T) {
+	dir := setupTestCgroup(t, testCgroupFiles{
+		memoryMax: "1000000000",
+		memoryCur: "600000000",
+	})
+	writeCgroupFile(t, filepath.Join(dir, "memory.stat"), "inactive_file 100000000\n")
+
+	m := NewMonitor(WithCgroupPath(dir))
+	stats := m.Stats()
+
+	if stats.MemoryWorkingSetBytes != 500000000 {
+		t.Errorf("MemoryWorkingSetBytes = %d, want 500000000", stats.MemoryWorkingSetBytes)
+	}
+	if stats.MemoryWorkingSetPercent != 50 {
+		t.Errorf("MemoryWorkingSetPercent = %f, want 50", stats.MemoryWorkingSetPercent)
+	}
+	// Raw usage is kept for backward compatibility.
+	if stats.MemoryBytes != 600000000 {
+		t.Errorf("MemoryBytes = %d, want 600000000", stats.MemoryBytes)
+	}
+}
+
+func TestParseMemoryStat_MissingFields(t *testing.T) {
+	stat := parseMemoryStat("anon 42\n")
+	if stat.Anon != 42 {
+		t.Errorf("Anon = %d, want 42", stat.Anon)
+	}
+	if stat.File != 0 || stat.Kernel != 0 {
+		t.Errorf("unset fields should be zero, got %+v", stat)
+	}
+}
@@ -0,0 +1,113 @@
+package cgroupv2
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"golang.org/x/sys/unix"
+)
+
+// WatchOOM watches memory.events for changes using inotify and delivers a
+// parsed MemoryEvents on the returned channel each time the kernel updates
+// the file - the documented cgroup v2 mechanism for OOM notification. This
+// lets applications react to memory pressure (e.g. shed load, self-restart)
+// without polling MemoryEvents in a loop.
+//
+// The channel is closed and the inotify watch is torn down when ctx is
+// cancelled. Cancellation is delivered via a self-pipe woken up alongside
+// the inotify fd in unix.Poll, since closing an fd on Linux does not
+// interrupt a concurrent blocking read on it.
+func (m *Monitor) WatchOOM(ctx context.Context) (<-chan MemoryEvents, error) {
+	path := m.cgroupPath + "/memory.events"
+
+	fd, err := unix.InotifyInit1(unix.IN_CLOEXEC | unix.IN_NONBLOCK)
+	if err != nil {
+		return nil, fmt.Errorf("cgroupv2: inotify_init: %w", err)
+	}
+
+	wd, err := unix.InotifyAddWatch(fd, path, unix.IN_MODIFY)
+	if err != nil {
+		unix.Close(fd)
+		return nil, fmt.Errorf("cgroupv2: inotify_add_watch on %s: %w", path, err)
+	}
+
+	var pipeFds [2]int
+	if err := unix.Pipe2(pipeFds[:], unix.O_CLOEXEC); err != nil {
+		unix.InotifyRmWatch(fd, uint32(wd))
+		unix.Close(fd)
+		return nil, fmt.Errorf("cgroupv2: pipe2: %w", err)
+	}
+	closeSignalR, closeSignalW := pipeFds[0], pipeFds[1]
+
+	var closeOnce sync.Once
+	closeAll := func() {
+		closeOnce.Do(func() {
+			unix.InotifyRmWatch(fd, uint32(wd))
+			unix.Close(fd)
+			unix.Close(closeSignalR)
+			unix.Close(closeSignalW)
+		})
+	}
+
+	events := make(chan MemoryEvents)
+
+	// Wake the poll loop when ctx is cancelled by closing the write end of
+	// the self-pipe, which makes the read end pollable.
+	go func() {
+		<-ctx.Done()
+		closeAll()
+	}()
+
+	go func() {
+		defer close(events)
+		defer closeAll()
+
+		pollFds := []unix.PollFd{
+			{Fd: int32(fd), Events: unix.POLLIN},
+			{Fd: int32(closeSignalR), Events: unix.POLLIN},
+		}
+		buf := make([]byte, unix.SizeofInotifyEvent+unix.NAME_MAX+1)
+
+		for {
+			_, err := unix.Poll(pollFds, -1)
+			if err != nil {
+				if err == unix.EINTR {
+					continue
+				}
+				return
+			}
+
+			if pollFds[1].Revents != 0 {
+				// ctx was cancelled (or the watch was otherwise torn down).
+				return
+			}
+			if pollFds[0].Revents&unix.POLLIN == 0 {
+				continue
+			}
+
+			n, err := unix.Read(fd, buf)
+			if err != nil {
+				if err == unix.EAGAIN {
+					continue
+				}
+				return
+			}
+			if n < unix.SizeofInotifyEvent {
+				continue
+			}
+
+			m.mu.Lock()
+			parsed := m.readMemoryEvents()
+			m.mu.Unlock()
+
+			select {
+			case events <- parsed:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return events, nil
+}
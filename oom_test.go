@@ -0,0 +1,58 @@
+package cgroupv2
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestMonitor_WatchOOM(t *testing.T) {
+	dir := setupTestCgroup(t, testCgroupFiles{})
+	eventsPath := filepath.Join(dir, "memory.events")
+	writeCgroupFile(t, eventsPath, "low 0\nhigh 0\nmax 0\noom 0\noom_kill 0\n")
+
+	m := NewMonitor(WithCgroupPath(dir))
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ch, err := m.WatchOOM(ctx)
+	if err != nil {
+		t.Skipf("inotify unavailable in this environment: %v", err)
+	}
+
+	writeCgroupFile(t, eventsPath, "low 0\nhigh 0\nmax 0\noom 1\noom_kill 1\n")
+
+	select {
+	case got := <-ch:
+		if got.OOM != 1 || got.OOMKill != 1 {
+			t.Errorf("WatchOOM delivered %+v, want OOM=1 OOMKill=1", got)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("timed out waiting for OOM event notification")
+	}
+}
+
+func TestMonitor_WatchOOM_StopsOnContextCancel(t *testing.T) {
+	dir := setupTestCgroup(t, testCgroupFiles{})
+	writeCgroupFile(t, filepath.Join(dir, "memory.events"), "low 0\nhigh 0\nmax 0\noom 0\noom_kill 0\n")
+
+	m := NewMonitor(WithCgroupPath(dir))
+	ctx, cancel := context.WithCancel(context.Background())
+
+	ch, err := m.WatchOOM(ctx)
+	if err != nil {
+		t.Skipf("inotify unavailable in this environment: %v", err)
+	}
+
+	cancel()
+
+	select {
+	case _, ok := <-ch:
+		if ok {
+			t.Error("expected channel to be closed after context cancellation")
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("timed out waiting for channel to close after cancellation")
+	}
+}
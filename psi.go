@@ -0,0 +1,164 @@
+package cgroupv2
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// ErrPSIUnavailable is returned when the kernel does not expose Pressure
+// Stall Information for a resource (the *.pressure files were added in
+// Linux 4.20; they are also absent when the "cgroup_pressure" sysctl or the
+// PSI config option is disabled).
+var ErrPSIUnavailable = errors.New("cgroupv2: PSI not available on this kernel")
+
+// PressureStats holds Pressure Stall Information (PSI) for CPU, memory, and
+// I/O, as reported by the cgroup v2 cpu.pressure, memory.pressure, and
+// io.pressure files. Each *Avg* field is a percentage (0-100) of time some or
+// all tasks in the cgroup spent stalled on the resource, averaged over the
+// trailing 10s/60s/300s window. PSI catches saturation that utilization
+// percentages miss, since a cgroup can stall waiting on a resource well
+// before its usage reaches 100% of its limit.
+type PressureStats struct {
+	// CPUSomeAvg10/60/300 is the percentage of time some task was stalled on CPU.
+	CPUSomeAvg10  float64
+	CPUSomeAvg60  float64
+	CPUSomeAvg300 float64
+	// CPUSomeTotal is the total stall time in microseconds (some tasks).
+	CPUSomeTotal uint64
+
+	// MemorySomeAvg10/60/300 is the percentage of time some task was stalled on memory.
+	MemorySomeAvg10  float64
+	MemorySomeAvg60  float64
+	MemorySomeAvg300 float64
+	// MemorySomeTotal is the total stall time in microseconds (some tasks).
+	MemorySomeTotal uint64
+	// MemoryFullAvg10/60/300 is the percentage of time all tasks were stalled on memory.
+	MemoryFullAvg10  float64
+	MemoryFullAvg60  float64
+	MemoryFullAvg300 float64
+	// MemoryFullTotal is the total stall time in microseconds (all tasks).
+	MemoryFullTotal uint64
+
+	// IOSomeAvg10/60/300 is the percentage of time some task was stalled on I/O.
+	IOSomeAvg10  float64
+	IOSomeAvg60  float64
+	IOSomeAvg300 float64
+	// IOSomeTotal is the total stall time in microseconds (some tasks).
+	IOSomeTotal uint64
+	// IOFullAvg10/60/300 is the percentage of time all tasks were stalled on I/O.
+	IOFullAvg10  float64
+	IOFullAvg60  float64
+	IOFullAvg300 float64
+	// IOFullTotal is the total stall time in microseconds (all tasks).
+	IOFullTotal uint64
+}
+
+// Pressure reads CPU, memory, and I/O PSI for the cgroup. On kernels that
+// don't expose PSI (pre-4.20, or with the feature disabled), it returns a
+// zero-valued PressureStats and ErrPSIUnavailable.
+func (m *Monitor) Pressure() (PressureStats, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.readPressure()
+}
+
+// readPressure does the work of Pressure. Callers must hold m.mu.
+func (m *Monitor) readPressure() (PressureStats, error) {
+	var stats PressureStats
+
+	cpuSome, _, err := m.readPressureFile("cpu.pressure")
+	if err != nil {
+		return PressureStats{}, ErrPSIUnavailable
+	}
+	stats.CPUSomeAvg10 = cpuSome.avg10
+	stats.CPUSomeAvg60 = cpuSome.avg60
+	stats.CPUSomeAvg300 = cpuSome.avg300
+	stats.CPUSomeTotal = cpuSome.total
+
+	memSome, memFull, err := m.readPressureFile("memory.pressure")
+	if err != nil {
+		return PressureStats{}, ErrPSIUnavailable
+	}
+	stats.MemorySomeAvg10 = memSome.avg10
+	stats.MemorySomeAvg60 = memSome.avg60
+	stats.MemorySomeAvg300 = memSome.avg300
+	stats.MemorySomeTotal = memSome.total
+	stats.MemoryFullAvg10 = memFull.avg10
+	stats.MemoryFullAvg60 = memFull.avg60
+	stats.MemoryFullAvg300 = memFull.avg300
+	stats.MemoryFullTotal = memFull.total
+
+	ioSome, ioFull, err := m.readPressureFile("io.pressure")
+	if err != nil {
+		return PressureStats{}, ErrPSIUnavailable
+	}
+	stats.IOSomeAvg10 = ioSome.avg10
+	stats.IOSomeAvg60 = ioSome.avg60
+	stats.IOSomeAvg300 = ioSome.avg300
+	stats.IOSomeTotal = ioSome.total
+	stats.IOFullAvg10 = ioFull.avg10
+	stats.IOFullAvg60 = ioFull.avg60
+	stats.IOFullAvg300 = ioFull.avg300
+	stats.IOFullTotal = ioFull.total
+
+	return stats, nil
+}
+
+// psiLine holds one parsed "some"/"full" line of a *.pressure file.
+type psiLine struct {
+	avg10, avg60, avg300 float64
+	total                uint64
+}
+
+// readPressureFile parses a *.pressure file's "some" and "full" lines, e.g.:
+//
+//	some avg10=0.00 avg60=0.00 avg300=0.00 total=0
+//	full avg10=0.00 avg60=0.00 avg300=0.00 total=0
+//
+// cpu.pressure has no "full" line; it is left zero-valued in that case.
+func (m *Monitor) readPressureFile(name string) (some, full psiLine, err error) {
+	content, err := readFile(m.cgroupPath + "/" + name)
+	if err != nil {
+		return psiLine{}, psiLine{}, err
+	}
+
+	for _, line := range strings.Split(content, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+
+		switch fields[0] {
+		case "some":
+			some = parsePSILine(fields[1:])
+		case "full":
+			full = parsePSILine(fields[1:])
+		}
+	}
+
+	return some, full, nil
+}
+
+// parsePSILine parses the "key=value" fields following the "some"/"full" tag.
+func parsePSILine(fields []string) psiLine {
+	var line psiLine
+	for _, field := range fields {
+		kv := strings.SplitN(field, "=", 2)
+		if len(kv) != 2 {
+			continue
+		}
+		switch kv[0] {
+		case "avg10":
+			line.avg10, _ = strconv.ParseFloat(kv[1], 64)
+		case "avg60":
+			line.avg60, _ = strconv.ParseFloat(kv[1], 64)
+		case "avg300":
+			line.avg300, _ = strconv.ParseFloat(kv[1], 64)
+		case "total":
+			line.total, _ = parseUint64(kv[1])
+		}
+	}
+	return line
+}
@@ -0,0 +1,71 @@
+package cgroupv2
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+)
+
+func TestMonitor_Pressure(t *testing.T) {
+	dir := setupTestCgroup(t, testCgroupFiles{})
+	writeCgroupFile(t, filepath.Join(dir, "cpu.pressure"),
+		"some avg10=1.50 avg60=2.25 avg300=0.10 total=123456\n")
+	writeCgroupFile(t, filepath.Join(dir, "memory.pressure"),
+		"some avg10=0.50 avg60=0.25 avg300=0.00 total=111\n"+
+			"full avg10=0.10 avg60=0.05 avg300=0.00 total=22\n")
+	writeCgroupFile(t, filepath.Join(dir, "io.pressure"),
+		"some avg10=5.00 avg60=4.00 avg300=3.00 total=999\n"+
+			"full avg10=1.00 avg60=0.50 avg300=0.00 total=50\n")
+
+	m := NewMonitor(WithCgroupPath(dir))
+	psi, err := m.Pressure()
+	if err != nil {
+		t.Fatalf("Pressure() error = %v", err)
+	}
+
+	if psi.CPUSomeAvg10 != 1.50 || psi.CPUSomeTotal != 123456 {
+		t.Errorf("CPU pressure = %+v, unexpected values", psi)
+	}
+	if psi.MemorySomeAvg60 != 0.25 || psi.MemoryFullAvg10 != 0.10 {
+		t.Errorf("memory pressure = %+v, unexpected values", psi)
+	}
+	if psi.IOSomeAvg300 != 3.00 || psi.IOFullTotal != 50 {
+		t.Errorf("IO pressure = %+v, unexpected values", psi)
+	}
+}
+
+func TestMonitor_PressureUnavailable(t *testing.T) {
+	dir := setupTestCgroup(t, testCgroupFiles{})
+	// No *.pressure files written - simulates a pre-4.20 kernel.
+	m := NewMonitor(WithCgroupPath(dir))
+
+	_, err := m.Pressure()
+	if !errors.Is(err, ErrPSIUnavailable) {
+		t.Errorf("Pressure() error = %v, want ErrPSIUnavailable", err)
+	}
+}
+
+func TestParsePSILine(t *testing.T) {
+	line := parsePSILine([]string{"avg10=12.34", "avg60=5.6", "avg300=1.2", "total=789"})
+	if line.avg10 != 12.34 || line.avg60 != 5.6 || line.avg300 != 1.2 || line.total != 789 {
+		t.Errorf("parsePSILine() = %+v, unexpected values", line)
+	}
+}
+
+func TestStats_IncludesPSI(t *testing.T) {
+	dir := setupTestCgroup(t, testCgroupFiles{
+		cpuMax:    "100000 100000",
+		cpuStat:   "usage_usec 1000000",
+		memoryMax: "1073741824",
+		memoryCur: "536870912",
+	})
+	writeCgroupFile(t, filepath.Join(dir, "cpu.pressure"), "some avg10=9.99 avg60=0 avg300=0 total=1\n")
+	writeCgroupFile(t, filepath.Join(dir, "memory.pressure"), "some avg10=0 avg60=0 avg300=0 total=0\nfull avg10=0 avg60=0 avg300=0 total=0\n")
+	writeCgroupFile(t, filepath.Join(dir, "io.pressure"), "some avg10=0 avg60=0 avg300=0 total=0\nfull avg10=0 avg60=0 avg300=0 total=0\n")
+
+	m := NewMonitor(WithCgroupPath(dir))
+	stats := m.Stats()
+	if stats.PSI.CPUSomeAvg10 != 9.99 {
+		t.Errorf("Stats().PSI.CPUSomeAvg10 = %f, want 9.99", stats.PSI.CPUSomeAvg10)
+	}
+}
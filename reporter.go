@@ -0,0 +1,199 @@
+package cgroupv2
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+)
+
+const defaultReporterInterval = 5 * time.Second
+
+// ReporterOption configures a Reporter.
+type ReporterOption func(*Reporter)
+
+// WithInterval sets how often the Reporter samples the underlying Monitor.
+// Defaults to 5 seconds.
+func WithInterval(d time.Duration) ReporterOption {
+	return func(r *Reporter) {
+		r.interval = d
+	}
+}
+
+// WithCPUThreshold registers fn to be called the first time CPUPercent
+// crosses pct (from below). Multiple ascending thresholds (e.g. 50, 75, 90)
+// each fire once per crossing, and re-arm if usage drops back below pct.
+func WithCPUThreshold(pct float64, fn func(Stats)) ReporterOption {
+	return func(r *Reporter) {
+		r.cpuThresholds = append(r.cpuThresholds, threshold{pct: pct, fn: fn})
+	}
+}
+
+// WithMemoryThreshold registers fn to be called the first time MemoryPercent
+// crosses pct (from below). Multiple ascending thresholds (e.g. 50, 75, 90)
+// each fire once per crossing, and re-arm if usage drops back below pct.
+func WithMemoryThreshold(pct float64, fn func(Stats)) ReporterOption {
+	return func(r *Reporter) {
+		r.memThresholds = append(r.memThresholds, threshold{pct: pct, fn: fn})
+	}
+}
+
+// threshold pairs a percentage level with the callback to fire when it's
+// crossed, plus whether usage is currently at or above it (it has fired and
+// is not re-armed until usage drops back below).
+type threshold struct {
+	pct     float64
+	fn      func(Stats)
+	crossed bool
+}
+
+// Snapshot is a point-in-time view of a Reporter's sampled data.
+type Snapshot struct {
+	// Current is the most recently sampled Stats.
+	Current Stats
+	// Max holds the peak CPUPercent and MemoryPercent observed.
+	Max Stats
+	// MeanCPUPercent is the mean CPUPercent across all samples.
+	MeanCPUPercent float64
+	// MeanMemoryPercent is the mean MemoryPercent across all samples.
+	MeanMemoryPercent float64
+}
+
+// Reporter wraps a Monitor, sampling it on a fixed interval in its own
+// goroutine. It tracks peak/mean values and fires threshold callbacks on
+// ascending crossings, replacing the common "call Stats() in a loop" pattern.
+type Reporter struct {
+	monitor  *Monitor
+	interval time.Duration
+
+	cpuThresholds []threshold
+	memThresholds []threshold
+
+	mu      sync.Mutex
+	current Stats
+	max     Stats
+	sumCPU  float64
+	sumMem  float64
+	samples int
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// NewReporter creates a Reporter that samples m. Call Start to begin sampling.
+func NewReporter(m *Monitor, opts ...ReporterOption) *Reporter {
+	r := &Reporter{
+		monitor:  m,
+		interval: defaultReporterInterval,
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	// Thresholds fire in ascending order so a sample that jumps past
+	// multiple levels at once reports them low-to-high.
+	sort.Slice(r.cpuThresholds, func(i, j int) bool { return r.cpuThresholds[i].pct < r.cpuThresholds[j].pct })
+	sort.Slice(r.memThresholds, func(i, j int) bool { return r.memThresholds[i].pct < r.memThresholds[j].pct })
+
+	return r
+}
+
+// Start begins sampling in a background goroutine. It returns immediately.
+// Sampling stops when ctx is cancelled or Stop is called.
+func (r *Reporter) Start(ctx context.Context) {
+	ctx, cancel := context.WithCancel(ctx)
+	r.cancel = cancel
+	r.done = make(chan struct{})
+
+	go func() {
+		defer close(r.done)
+
+		ticker := time.NewTicker(r.interval)
+		defer ticker.Stop()
+
+		r.sample()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				r.sample()
+			}
+		}
+	}()
+}
+
+// Stop ends sampling and blocks until the background goroutine has exited.
+func (r *Reporter) Stop() {
+	if r.cancel == nil {
+		return
+	}
+	r.cancel()
+	<-r.done
+}
+
+// Max returns a Stats whose CPUPercent and MemoryPercent are the peak values
+// observed so far, tracked independently of each other. All other fields
+// (MemoryBytes, CPULimitCores, PSI, IO counters, etc.) are zero-valued: Max
+// only tracks the two percentage fields, not a full snapshot from the
+// moment either one peaked.
+func (r *Reporter) Max() Stats {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.max
+}
+
+// Snapshot returns the current, peak, and mean stats observed so far.
+func (r *Reporter) Snapshot() Snapshot {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	snap := Snapshot{
+		Current: r.current,
+		Max:     r.max,
+	}
+	if r.samples > 0 {
+		snap.MeanCPUPercent = r.sumCPU / float64(r.samples)
+		snap.MeanMemoryPercent = r.sumMem / float64(r.samples)
+	}
+	return snap
+}
+
+func (r *Reporter) sample() {
+	stats := r.monitor.Stats()
+
+	r.mu.Lock()
+	r.current = stats
+	r.samples++
+	r.sumCPU += stats.CPUPercent
+	r.sumMem += stats.MemoryPercent
+
+	if stats.CPUPercent > r.max.CPUPercent {
+		r.max.CPUPercent = stats.CPUPercent
+	}
+	if stats.MemoryPercent > r.max.MemoryPercent {
+		r.max.MemoryPercent = stats.MemoryPercent
+	}
+	r.mu.Unlock()
+
+	fireThresholds(r.cpuThresholds, stats.CPUPercent, stats)
+	fireThresholds(r.memThresholds, stats.MemoryPercent, stats)
+}
+
+// fireThresholds invokes the callback for each threshold that value just
+// crossed from below, de-duplicating so a steady-state value above pct
+// doesn't re-fire every tick. A threshold re-arms once value drops back
+// below it.
+func fireThresholds(thresholds []threshold, value float64, stats Stats) {
+	for i := range thresholds {
+		t := &thresholds[i]
+		if value >= t.pct {
+			if !t.crossed {
+				t.crossed = true
+				t.fn(stats)
+			}
+		} else {
+			t.crossed = false
+		}
+	}
+}
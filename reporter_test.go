@@ -0,0 +1,127 @@
+package cgroupv2
+
+import (
+	"context"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestReporter_TracksMax(t *testing.T) {
+	dir := setupTestCgroup(t, testCgroupFiles{
+		cpuMax:    "100000 100000",
+		cpuStat:   "usage_usec 0",
+		memoryMax: "1000000000",
+		memoryCur: "100000000", // 10%
+	})
+	m := NewMonitor(WithCgroupPath(dir))
+
+	r := NewReporter(m, WithInterval(5*time.Millisecond))
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	r.Start(ctx)
+	defer r.Stop()
+
+	time.Sleep(10 * time.Millisecond)
+	writeCgroupFile(t, filepath.Join(dir, "memory.current"), "900000000") // 90%
+	time.Sleep(20 * time.Millisecond)
+
+	max := r.Max()
+	if max.MemoryPercent < 89 {
+		t.Errorf("Max().MemoryPercent = %f, want >= 89", max.MemoryPercent)
+	}
+}
+
+func TestReporter_ThresholdFiresOnce(t *testing.T) {
+	dir := setupTestCgroup(t, testCgroupFiles{
+		memoryMax: "1000000000",
+		memoryCur: "800000000", // 80%, already past threshold
+	})
+	m := NewMonitor(WithCgroupPath(dir))
+
+	var mu sync.Mutex
+	fired := 0
+	r := NewReporter(m,
+		WithInterval(5*time.Millisecond),
+		WithMemoryThreshold(75, func(Stats) {
+			mu.Lock()
+			fired++
+			mu.Unlock()
+		}),
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	r.Start(ctx)
+	time.Sleep(30 * time.Millisecond)
+	r.Stop()
+
+	mu.Lock()
+	defer mu.Unlock()
+	if fired != 1 {
+		t.Errorf("threshold fired %d times, want exactly 1", fired)
+	}
+}
+
+func TestReporter_ThresholdRearmsAfterDrop(t *testing.T) {
+	dir := setupTestCgroup(t, testCgroupFiles{
+		memoryMax: "1000000000",
+		memoryCur: "100000000", // 10%, below threshold
+	})
+	m := NewMonitor(WithCgroupPath(dir))
+
+	var mu sync.Mutex
+	fired := 0
+	r := NewReporter(m,
+		WithInterval(5*time.Millisecond),
+		WithMemoryThreshold(50, func(Stats) {
+			mu.Lock()
+			fired++
+			mu.Unlock()
+		}),
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	r.Start(ctx)
+
+	time.Sleep(10 * time.Millisecond)
+	writeCgroupFile(t, filepath.Join(dir, "memory.current"), "600000000") // cross up
+	time.Sleep(10 * time.Millisecond)
+	writeCgroupFile(t, filepath.Join(dir, "memory.current"), "100000000") // drop back down
+	time.Sleep(10 * time.Millisecond)
+	writeCgroupFile(t, filepath.Join(dir, "memory.current"), "600000000") // cross up again
+	time.Sleep(10 * time.Millisecond)
+
+	r.Stop()
+
+	mu.Lock()
+	defer mu.Unlock()
+	if fired != 2 {
+		t.Errorf("threshold fired %d times, want 2 (one per crossing)", fired)
+	}
+}
+
+func TestReporter_Snapshot(t *testing.T) {
+	dir := setupTestCgroup(t, testCgroupFiles{
+		memoryMax: "1000000000",
+		memoryCur: "500000000",
+	})
+	m := NewMonitor(WithCgroupPath(dir))
+
+	r := NewReporter(m, WithInterval(5*time.Millisecond))
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	r.Start(ctx)
+	time.Sleep(20 * time.Millisecond)
+	r.Stop()
+
+	snap := r.Snapshot()
+	if snap.Current.MemoryPercent != 50 {
+		t.Errorf("Snapshot().Current.MemoryPercent = %f, want 50", snap.Current.MemoryPercent)
+	}
+	if snap.MeanMemoryPercent != 50 {
+		t.Errorf("Snapshot().MeanMemoryPercent = %f, want 50", snap.MeanMemoryPercent)
+	}
+}
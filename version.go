@@ -0,0 +1,83 @@
+package cgroupv2
+
+import "os"
+
+// Version identifies which cgroup hierarchy a Monitor is reading from.
+type Version int
+
+const (
+	// VersionUnknown means the hierarchy has not been detected yet.
+	VersionUnknown Version = iota
+	// VersionV2 is the unified cgroup v2 hierarchy.
+	VersionV2
+	// VersionV1 is the legacy per-controller cgroup v1 hierarchy.
+	VersionV1
+)
+
+const (
+	v1CPUPath    = "cpu,cpuacct"
+	v1MemoryPath = "memory"
+
+	// maxV1MemoryLimitBytes is the threshold above which memory.limit_in_bytes
+	// is treated as "unlimited". The kernel reports unset v1 memory limits as
+	// a page-count sentinel (e.g. 9223372036854771712 on 64-bit), not "max".
+	maxV1MemoryLimitBytes = 1 << 62
+)
+
+// String returns a human-readable name for the version.
+func (v Version) String() string {
+	switch v {
+	case VersionV2:
+		return "v2"
+	case VersionV1:
+		return "v1"
+	default:
+		return "unknown"
+	}
+}
+
+// WithForceVersion forces the Monitor to treat the cgroup hierarchy as the
+// given version instead of auto-detecting it. This is primarily useful for
+// testing v1 fallback behavior on a machine that only has v2 available.
+func WithForceVersion(v Version) Option {
+	return func(m *Monitor) {
+		m.forcedVersion = v
+	}
+}
+
+// Version returns the cgroup hierarchy version this Monitor is reading from.
+// It detects the version lazily on first use and caches the result.
+func (m *Monitor) Version() Version {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.detectVersion()
+}
+
+// detectVersion resolves and caches the cgroup version. Callers must hold m.mu.
+func (m *Monitor) detectVersion() Version {
+	if m.forcedVersion != VersionUnknown {
+		return m.forcedVersion
+	}
+	if m.version != VersionUnknown {
+		return m.version
+	}
+
+	if _, err := os.Stat(m.cgroupPath + "/" + cgroupControllersFile); err == nil {
+		m.version = VersionV2
+		return m.version
+	}
+
+	if _, err := os.Stat(m.cgroupPath + "/" + v1CPUPath); err == nil {
+		m.version = VersionV1
+		return m.version
+	}
+	if _, err := os.Stat(m.cgroupPath + "/" + v1MemoryPath); err == nil {
+		m.version = VersionV1
+		return m.version
+	}
+
+	// Default to v2; readers will surface the real error when they try to
+	// open files that don't exist.
+	m.version = VersionV2
+	return m.version
+}
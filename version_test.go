@@ -0,0 +1,170 @@
+package cgroupv2
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestMonitor_ConcurrentCPUAndMemoryPercent(t *testing.T) {
+	dir := setupTestCgroup(t, testCgroupFiles{
+		cpuMax:    "100000 100000",
+		cpuStat:   "usage_usec 1000000",
+		memoryMax: "1073741824",
+		memoryCur: "536870912",
+	})
+	m := NewMonitor(WithCgroupPath(dir))
+
+	done := make(chan bool)
+	go func() {
+		for i := 0; i < 100; i++ {
+			m.CPUPercent()
+		}
+		done <- true
+	}()
+	go func() {
+		for i := 0; i < 100; i++ {
+			m.MemoryPercent()
+		}
+		done <- true
+	}()
+
+	<-done
+	<-done
+}
+
+func TestVersion_DetectsV2(t *testing.T) {
+	dir := setupTestCgroup(t, testCgroupFiles{
+		cpuMax: "100000 100000",
+	})
+	m := NewMonitor(WithCgroupPath(dir))
+	if v := m.Version(); v != VersionV2 {
+		t.Errorf("Version() = %v, want %v", v, VersionV2)
+	}
+}
+
+func TestVersion_DetectsV1(t *testing.T) {
+	dir := setupTestV1Cgroup(t, testV1CgroupFiles{
+		cfsQuota:  "100000",
+		cfsPeriod: "100000",
+		usage:     "1000000000",
+		limit:     "1073741824",
+		usageIn:   "536870912",
+	})
+	m := NewMonitor(WithCgroupPath(dir))
+	if v := m.Version(); v != VersionV1 {
+		t.Errorf("Version() = %v, want %v", v, VersionV1)
+	}
+}
+
+func TestWithForceVersion(t *testing.T) {
+	dir := setupTestV1Cgroup(t, testV1CgroupFiles{
+		cfsQuota:  "100000",
+		cfsPeriod: "100000",
+		usage:     "1000000000",
+		limit:     "1073741824",
+		usageIn:   "536870912",
+	})
+	// This directory looks like v2 would never match (no cgroup.controllers),
+	// but force v1 explicitly anyway to exercise the override.
+	m := NewMonitor(WithCgroupPath(dir), WithForceVersion(VersionV1))
+	if v := m.Version(); v != VersionV1 {
+		t.Errorf("Version() = %v, want %v", v, VersionV1)
+	}
+}
+
+func TestV1_CPUPercent(t *testing.T) {
+	dir := setupTestV1Cgroup(t, testV1CgroupFiles{
+		cfsQuota:  "100000", // 1 core limit
+		cfsPeriod: "100000",
+		usage:     "1000000000", // 1000ms in nanoseconds
+	})
+	m := NewMonitor(WithCgroupPath(dir), WithForceVersion(VersionV1))
+
+	pct := m.CPUPercent()
+	if pct != 0 {
+		t.Errorf("first call should return 0, got %f", pct)
+	}
+
+	time.Sleep(10 * time.Millisecond)
+	writeCgroupFile(t, filepath.Join(dir, v1CPUPath, "cpuacct.usage"), "1100000000") // +100ms
+
+	pct = m.CPUPercent()
+	if pct <= 0 {
+		t.Errorf("second call should return positive percentage, got %f", pct)
+	}
+}
+
+func TestV1_MemoryPercent(t *testing.T) {
+	dir := setupTestV1Cgroup(t, testV1CgroupFiles{
+		limit:   "1000000000",
+		usageIn: "250000000", // 25%
+	})
+	m := NewMonitor(WithCgroupPath(dir), WithForceVersion(VersionV1))
+
+	pct := m.MemoryPercent()
+	if pct != 25.0 {
+		t.Errorf("MemoryPercent() = %f, want 25.0", pct)
+	}
+}
+
+func TestV1_MemoryUnlimited(t *testing.T) {
+	dir := setupTestV1Cgroup(t, testV1CgroupFiles{
+		limit:   "9223372036854771712", // kernel's "no limit" sentinel
+		usageIn: "1000000",
+	})
+	m := NewMonitor(WithCgroupPath(dir), WithForceVersion(VersionV1))
+
+	pct := m.MemoryPercent()
+	if pct != 0 {
+		t.Errorf("MemoryPercent with unlimited v1 memory = %f, want 0", pct)
+	}
+}
+
+// v1 test helpers
+
+type testV1CgroupFiles struct {
+	cfsQuota  string
+	cfsPeriod string
+	usage     string
+	limit     string
+	usageIn   string
+}
+
+func setupTestV1Cgroup(tb testing.TB, files testV1CgroupFiles) string {
+	tb.Helper()
+
+	dir, err := os.MkdirTemp("", "cgroupv2-v1-test-*")
+	if err != nil {
+		tb.Fatal(err)
+	}
+	tb.Cleanup(func() { os.RemoveAll(dir) })
+
+	cpuDir := filepath.Join(dir, v1CPUPath)
+	memDir := filepath.Join(dir, v1MemoryPath)
+	if err := os.MkdirAll(cpuDir, 0755); err != nil {
+		tb.Fatal(err)
+	}
+	if err := os.MkdirAll(memDir, 0755); err != nil {
+		tb.Fatal(err)
+	}
+
+	if files.cfsQuota != "" {
+		writeCgroupFile(tb, filepath.Join(cpuDir, "cpu.cfs_quota_us"), files.cfsQuota)
+	}
+	if files.cfsPeriod != "" {
+		writeCgroupFile(tb, filepath.Join(cpuDir, "cpu.cfs_period_us"), files.cfsPeriod)
+	}
+	if files.usage != "" {
+		writeCgroupFile(tb, filepath.Join(cpuDir, "cpuacct.usage"), files.usage)
+	}
+	if files.limit != "" {
+		writeCgroupFile(tb, filepath.Join(memDir, "memory.limit_in_bytes"), files.limit)
+	}
+	if files.usageIn != "" {
+		writeCgroupFile(tb, filepath.Join(memDir, "memory.usage_in_bytes"), files.usageIn)
+	}
+
+	return dir
+}